@@ -0,0 +1,105 @@
+package rapidid
+
+import (
+	"bytes"
+	"time"
+)
+
+// Time decodes the 56-bit timestamp embedded in the ID and returns the
+// instant it represents.
+func (t ID) Time() time.Time {
+	ticks := t.Timestamp()
+	return epochTime.Add(time.Duration(ticks) * 100 * time.Nanosecond)
+}
+
+// Timestamp returns the raw counter of 100ns ticks since epochTime that was
+// encoded into the ID at generation time. It returns 0 for an ID shorter
+// than the 7 timestamp bytes; other malformed IDs are not detected.
+func (t ID) Timestamp() uint64 {
+	v := t.valuePart()
+	if len(v) < timeBytesLen {
+		return 0
+	}
+	ts := uint64(v[0])<<56 | uint64(v[1])<<48 | uint64(v[2])<<40 | uint64(v[3])<<32 |
+		uint64(v[4])<<24 | uint64(v[5])<<16 | uint64(v[6])<<8
+	return ts >> 8
+}
+
+// Prefix returns the prefix the ID was generated with, or an empty string if
+// the ID is no longer than byteLength or contains no "-" separator. For a
+// longer ID it returns whatever precedes the first "-", without validating it.
+func (t ID) Prefix() string {
+	if len(t) <= byteLength {
+		return ""
+	}
+	separatorIndex := bytes.Index(t[:], separatorBytes)
+	if separatorIndex == -1 {
+		return ""
+	}
+	return string(t[0:separatorIndex])
+}
+
+// Random returns a copy of the 12-byte random component of the ID. It
+// returns 12 zero bytes for an ID shorter than byteLength; other malformed
+// IDs are not detected.
+func (t ID) Random() []byte {
+	v := t.valuePart()
+	rnd := make([]byte, randomBytesLen)
+	if len(v) < byteLength {
+		return rnd
+	}
+	copy(rnd, v[timeBytesLen:byteLength])
+	return rnd
+}
+
+// NodeID returns a copy of the 3 bytes at the start of the random component
+// reserved for a per-process identifier by WithNodeID. For IDs generated by
+// a Generator without WithNodeID, these bytes are plain randomness. It
+// returns 3 zero bytes for an ID shorter than the timestamp plus node ID
+// bytes; other malformed IDs are not detected.
+func (t ID) NodeID() []byte {
+	v := t.valuePart()
+	id := make([]byte, nodeIDLen)
+	if len(v) < timeBytesLen+nodeIDLen {
+		return id
+	}
+	copy(id, v[timeBytesLen:timeBytesLen+nodeIDLen])
+	return id
+}
+
+// Validate checks that the ID has a well-formed prefix and length, without
+// round-tripping through Parse. It validates against the default
+// Generator's conventions (a "-" separator and a 3 character prefix); for
+// an ID from a custom Generator, use Generator.Parse instead.
+func (t ID) Validate() error {
+	if len(t) <= byteLength {
+		if len(t) != byteLength {
+			return errBytesSizeMismatch
+		}
+		return nil
+	}
+	separatorIndex := bytes.Index(t[:], separatorBytes)
+	if separatorIndex == -1 {
+		return errBytesSizeMismatch
+	}
+	prefix := string(t[0:separatorIndex])
+	if err := validatePrefix(prefix, false); err != nil {
+		return err
+	}
+	if len(t) != byteLength+len(prefix)+len(separatorBytes) {
+		return errBytesSizeMismatch
+	}
+	return nil
+}
+
+// valuePart returns the byteLength-sized value portion of the ID, stripping
+// the prefix and separator if present. The value is always the trailing
+// byteLength bytes of the ID regardless of the prefix or separator used to
+// generate it, so this works for IDs from any Generator, including one
+// configured with WithSeparator or WithPrefixLength.
+func (t ID) valuePart() []byte {
+	if len(t) > byteLength {
+		return t[len(t)-byteLength:]
+	}
+	return t[:]
+}
@@ -0,0 +1,170 @@
+package rapidid
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// scratchPool holds reusable byte slices for AppendString, so appending an
+// ID to a log line or SQL statement doesn't need to allocate a fresh buffer
+// to build the raw ID bytes before encoding them.
+var scratchPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, prefixAllowedLen+1+byteLength)
+		return &b
+	},
+}
+
+// GenerateN is the same as Generator.GenerateN on the default Generator.
+func GenerateN(prefix string, n int) []string {
+	return defaultGenerator.GenerateN(prefix, n)
+}
+
+// AppendString appends the string encoding of a freshly generated ID with
+// the given prefix to dst and returns the extended slice, analogous to
+// strconv.AppendInt.
+func AppendString(dst []byte, prefix string) []byte {
+	return defaultGenerator.AppendString(dst, prefix)
+}
+
+// GenerateN generates n IDs with the given prefix, drawing a single
+// crypto/rand.Read per call instead of one per ID (see fillBytes).
+func (g *Generator) GenerateN(prefix string, n int) []string {
+	dst := make([]string, n)
+	if err := g.FillStrings(prefix, dst); err != nil {
+		panic(err)
+	}
+	return dst
+}
+
+// FillStrings fills dst with the string encoding of len(dst) freshly
+// generated IDs sharing the given prefix. Every ID gets its own independently
+// random component, just like New; see fillBytes for how the batch stays
+// ordered without reusing randomness across IDs.
+func (g *Generator) FillStrings(prefix string, dst []string) error {
+	n := len(dst)
+	if n == 0 {
+		return nil
+	}
+	prefixLen, pfx, err := g.splitPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	idLen := prefixLen + byteLength
+	ids := make([][]byte, n)
+	buf := make([]byte, n*idLen)
+	for i := range ids {
+		ids[i] = buf[i*idLen : (i+1)*idLen]
+	}
+	if err := g.fillBytes(pfx, prefixLen, ids); err != nil {
+		return err
+	}
+	for i, idb := range ids {
+		dst[i] = g.string(ID(idb))
+	}
+	return nil
+}
+
+// FillBytes fills each element of dst with the raw bytes of a freshly
+// generated ID sharing the given prefix, writing directly into the
+// caller-provided slices to avoid a per-ID allocation. Every dst[i] must
+// already have the length a single ID with this prefix would occupy.
+func (g *Generator) FillBytes(prefix string, dst [][]byte) error {
+	prefixLen, pfx, err := g.splitPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return g.fillBytes(pfx, prefixLen, dst)
+}
+
+// fillBytes draws a single n*randomBytesLen slice from crypto/rand for the
+// whole batch, turning n crypto/rand.Read calls into one, and gives each ID
+// its own independent randomBytesLen slice of it, so knowing one ID's random
+// component reveals nothing about its neighbors. Every ID in a batch is measured at the same instant (a
+// single time.Since call), so ordering across the batch instead comes from
+// the timestamp: each subsequent ID's 56-bit timestamp counter is advanced by
+// one tick rather than resampled, the same width New's granularity already
+// offers. This trades away clock resolution equal to len(dst)-1 ticks (each
+// 100ns) for a batch's duration, which a batch large enough to take that long
+// to mint would already be past.
+func (g *Generator) fillBytes(pfx string, prefixLen int, dst [][]byte) error {
+	n := len(dst)
+	if n == 0 {
+		return nil
+	}
+	idLen := prefixLen + byteLength
+	for i, d := range dst {
+		if len(d) != idLen {
+			return fmt.Errorf("rapidid: dst[%d] must have length %d", i, idLen)
+		}
+	}
+
+	ts := uint64(time.Since(g.epoch) / 100) // timestamp measured in 100 unit nanoseconds
+	ts = (ts << 8) & 0xFFFFFFFFFFFFFF00     // the 56 least significant bits of the time
+
+	rnd := make([]byte, n*randomBytesLen)
+	if _, err := rand.Read(rnd); err != nil {
+		return err
+	}
+
+	for i, d := range dst {
+		r := rnd[i*randomBytesLen : (i+1)*randomBytesLen]
+		if g.nodeID != nil {
+			copy(r[:nodeIDLen], g.nodeID)
+		}
+		copy(d[0:prefixLen], pfx)
+		putTimestampBytes(d[prefixLen:], ts+uint64(i)<<8)
+		copy(d[prefixLen+timeBytesLen:], r)
+	}
+	return nil
+}
+
+// AppendString appends the string encoding of a freshly generated ID with
+// the given prefix to dst, reusing a pooled scratch buffer to build the raw
+// ID bytes instead of allocating one per call.
+func (g *Generator) AppendString(dst []byte, prefix string) []byte {
+	prefixLen, pfx, err := g.splitPrefix(prefix)
+	if err != nil {
+		panic(err)
+	}
+	idLen := prefixLen + byteLength
+
+	bufp := scratchPool.Get().(*[]byte)
+	buf := *bufp
+	if cap(buf) < idLen {
+		buf = make([]byte, idLen)
+	} else {
+		buf = buf[:idLen]
+	}
+
+	ts := uint64(time.Since(g.epoch) / 100)
+	ts = (ts << 8) & 0xFFFFFFFFFFFFFF00
+	rnd := getRandomBytes()
+	if g.nodeID != nil {
+		copy(rnd[:nodeIDLen], g.nodeID)
+	}
+	copy(buf[0:prefixLen], pfx)
+	putTimestampBytes(buf[prefixLen:], ts)
+	copy(buf[prefixLen+timeBytesLen:], rnd)
+
+	dst = append(dst, g.string(ID(buf))...)
+	*bufp = buf
+	scratchPool.Put(bufp)
+	return dst
+}
+
+// splitPrefix validates prefix and returns the byte length it occupies in a
+// generated ID (0 if empty) along with the prefix plus trailing separator.
+func (g *Generator) splitPrefix(prefix string) (prefixLen int, pfx string, err error) {
+	prefix = strings.TrimSuffix(prefix, g.separator)
+	if err := g.validatePrefix(prefix, true); err != nil {
+		return 0, "", err
+	}
+	if len(prefix) == 0 {
+		return 0, "", nil
+	}
+	return g.prefixLen + len(g.sepBytes), prefix + g.separator, nil
+}
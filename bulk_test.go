@@ -0,0 +1,133 @@
+package rapidid
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestFillBytesOrderedAndUnique(t *testing.T) {
+	const n = 500
+	g := NewGenerator()
+	buf := make([]byte, n*byteLength)
+	dst := make([][]byte, n)
+	for i := range dst {
+		dst[i] = buf[i*byteLength : (i+1)*byteLength]
+	}
+	if err := g.FillBytes("", dst); err != nil {
+		t.Fatalf("FillBytes: %v", err)
+	}
+
+	seen := make(map[string]bool, n)
+	for i, d := range dst {
+		key := string(d)
+		if seen[key] {
+			t.Fatalf("dst[%d] duplicates an earlier ID: %x", i, d)
+		}
+		seen[key] = true
+		if i > 0 && bytes.Compare(dst[i-1], d) >= 0 {
+			t.Fatalf("dst[%d] = %x does not sort after dst[%d] = %x", i, d, i-1, dst[i-1])
+		}
+	}
+}
+
+func TestFillBytesRandomComponentsAreIndependent(t *testing.T) {
+	const n = 2
+	g := NewGenerator()
+	buf := make([]byte, n*byteLength)
+	dst := make([][]byte, n)
+	for i := range dst {
+		dst[i] = buf[i*byteLength : (i+1)*byteLength]
+	}
+	if err := g.FillBytes("", dst); err != nil {
+		t.Fatalf("FillBytes: %v", err)
+	}
+	a, b := ID(dst[0]).Random(), ID(dst[1]).Random()
+	if bytes.Equal(a, b) {
+		t.Fatalf("random components of consecutive IDs matched: %x == %x (want independent draws, not a shared seed plus one)", a, b)
+	}
+}
+
+func TestFillBytesAppliesNodeID(t *testing.T) {
+	const n = 10
+	nodeID := []byte{0xAA, 0xBB, 0xCC}
+	g := NewGenerator(WithNodeID(nodeID))
+	buf := make([]byte, n*byteLength)
+	dst := make([][]byte, n)
+	for i := range dst {
+		dst[i] = buf[i*byteLength : (i+1)*byteLength]
+	}
+	if err := g.FillBytes("", dst); err != nil {
+		t.Fatalf("FillBytes: %v", err)
+	}
+	for i, d := range dst {
+		if got := ID(d).NodeID(); !bytes.Equal(got, nodeID) {
+			t.Fatalf("dst[%d].NodeID() = %x, want %x", i, got, nodeID)
+		}
+	}
+}
+
+func TestFillBytesRejectsWrongLength(t *testing.T) {
+	g := NewGenerator()
+	dst := [][]byte{make([]byte, byteLength), make([]byte, byteLength+1)}
+	err := g.FillBytes("", dst)
+	if err == nil {
+		t.Fatal("expected an error for a mismatched dst length")
+	}
+	if want := fmt.Sprintf("rapidid: dst[%d] must have length %d", 1, byteLength); err.Error() != want {
+		t.Fatalf("err = %q, want %q", err.Error(), want)
+	}
+}
+
+func BenchmarkGeneratePerCall(b *testing.B) {
+	g := NewGenerator()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = g.GenerateWithPrefix("acc")
+	}
+}
+
+func BenchmarkGenerateN(b *testing.B) {
+	const batch = 1000
+	g := NewGenerator()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = g.GenerateN("acc", batch)
+	}
+}
+
+func BenchmarkFillBytesPerCall(b *testing.B) {
+	g := NewGenerator()
+	dst := make([]byte, byteLength)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := g.FillBytes("", [][]byte{dst}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFillBytesBatch(b *testing.B) {
+	const batch = 1000
+	g := NewGenerator()
+	buf := make([]byte, batch*byteLength)
+	dst := make([][]byte, batch)
+	for i := range dst {
+		dst[i] = buf[i*byteLength : (i+1)*byteLength]
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := g.FillBytes("", dst); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAppendString(b *testing.B) {
+	g := NewGenerator()
+	dst := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dst = g.AppendString(dst[:0], "acc")
+	}
+}
@@ -0,0 +1,89 @@
+package rapidid
+
+import (
+	"encoding/base32"
+
+	"github.com/mr-tron/base58"
+)
+
+// Encoding abstracts the string encoding used for the value portion of an
+// ID, so a Generator can be configured with an alternative to the default
+// base58 alphabet.
+type Encoding interface {
+	// Name identifies the encoding, used in error messages.
+	Name() string
+	// Encode returns the string encoding of b.
+	Encode(b []byte) string
+	// Decode parses s, produced by Encode, back into bytes.
+	Decode(s string) ([]byte, error)
+	// EncodedLen returns the string length Encode produces for byteLen
+	// input bytes.
+	EncodedLen(byteLen int) int
+	// Alphabet returns the set of characters Encode can produce, so
+	// NewGenerator can reject a WithSeparator value that would collide
+	// with it.
+	Alphabet() string
+}
+
+// EncodingBase58 is the default encoding. It reorders the usual Bitcoin
+// alphabet so that the encoded string preserves the lexicographic ordering
+// of the input bytes.
+var EncodingBase58 Encoding = base58Encoding{alphabet: alphabets, chars: base58AlphabetChars}
+
+type base58Encoding struct {
+	alphabet *base58.Alphabet
+	chars    string
+}
+
+func (e base58Encoding) Name() string { return "base58" }
+
+func (e base58Encoding) Alphabet() string { return e.chars }
+
+func (e base58Encoding) Encode(b []byte) string {
+	return base58.EncodeAlphabet(b, e.alphabet)
+}
+
+func (e base58Encoding) Decode(s string) ([]byte, error) {
+	return base58.DecodeAlphabet(s, e.alphabet)
+}
+
+func (e base58Encoding) EncodedLen(byteLen int) int {
+	return byteLen * 8 / 6
+}
+
+// base32HexAlphabetChars is RFC 4648's "base32hex" alphabet, lowercased so the
+// encoded string can round-trip through case-insensitive transports (DNS
+// labels, some file systems, HTTP headers mangled by lowercasing proxies)
+// without losing information, while still preserving the lexicographic
+// ordering of the input bytes.
+const base32HexAlphabetChars = "0123456789abcdefghijklmnopqrstuv"
+
+var base32HexAlphabet = base32.NewEncoding(base32HexAlphabetChars).WithPadding(base32.NoPadding)
+
+// EncodingBase32Hex is an alternative to EncodingBase58 for deployments that
+// need a case-insensitive, URL-safe encoding, mirroring xid's choice of
+// lowercase base32-hex.
+var EncodingBase32Hex Encoding = base32HexEncoding{}
+
+type base32HexEncoding struct{}
+
+func (base32HexEncoding) Name() string { return "base32hex" }
+
+func (base32HexEncoding) Encode(b []byte) string {
+	return base32HexAlphabet.EncodeToString(b)
+}
+
+func (base32HexEncoding) Decode(s string) ([]byte, error) {
+	return base32HexAlphabet.DecodeString(s)
+}
+
+func (base32HexEncoding) EncodedLen(byteLen int) int {
+	return base32HexAlphabet.EncodedLen(byteLen)
+}
+
+func (base32HexEncoding) Alphabet() string { return base32HexAlphabetChars }
+
+// knownEncodings lists the encodings Parse tries to detect a string against
+// when it doesn't match the Generator's configured encoding, so deployments
+// migrating between encodings can read IDs produced by either.
+var knownEncodings = []Encoding{EncodingBase58, EncodingBase32Hex}
@@ -0,0 +1,15 @@
+package rapidid
+
+import "testing"
+
+func TestBase32HexRoundTrip(t *testing.T) {
+	g := NewGenerator(WithEncoding(EncodingBase32Hex))
+	s := g.GenerateWithPrefix("acc")
+	id, err := g.Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", s, err)
+	}
+	if got := g.string(id); got != s {
+		t.Fatalf("round trip mismatch: got %q, want %q", got, s)
+	}
+}
@@ -0,0 +1,249 @@
+package rapidid
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Generator produces and parses IDs using a configurable epoch, prefix
+// length, separator and encoding. Use NewGenerator to build one; the zero
+// value is not usable.
+type Generator struct {
+	epoch     time.Time
+	prefixLen int
+	separator string
+	sepBytes  []byte
+	encoding  Encoding
+	nodeID    []byte
+}
+
+// Option configures a Generator built with NewGenerator.
+type Option func(*Generator)
+
+// WithEpoch sets the instant IDs are measured from. Defaults to 2022-01-01
+// UTC, the package's epochTime.
+func WithEpoch(t time.Time) Option {
+	return func(g *Generator) { g.epoch = t }
+}
+
+// WithPrefixLength sets the number of characters a non-empty prefix must
+// have. Defaults to 3.
+func WithPrefixLength(n int) Option {
+	return func(g *Generator) { g.prefixLen = n }
+}
+
+// WithSeparator sets the string placed between a prefix and the encoded
+// value. Defaults to "-".
+func WithSeparator(sep string) Option {
+	return func(g *Generator) { g.separator = sep }
+}
+
+// WithEncoding sets the string encoding used for the value portion of
+// generated IDs. Defaults to EncodingBase58.
+func WithEncoding(enc Encoding) Option {
+	return func(g *Generator) { g.encoding = enc }
+}
+
+// WithNodeID carves nodeIDLen bytes of the random region into a stable
+// per-process identifier, so IDs can be attributed back to the process that
+// minted them. id is copied and truncated or zero-padded to nodeIDLen bytes.
+// Without this option, generated IDs are byte-identical to a Generator with
+// no options at all. Use DefaultNodeID for a reasonable default derived from
+// the hostname and PID.
+func WithNodeID(id []byte) Option {
+	n := make([]byte, nodeIDLen)
+	copy(n, id)
+	return func(g *Generator) { g.nodeID = n }
+}
+
+// NewGenerator builds a Generator from the supplied options. Without any
+// options it behaves identically to the package-level Generate/Parse
+// functions. It panics if WithSeparator and WithEncoding are combined such
+// that the separator shares a character with the encoding's alphabet, since
+// Parse's split on the first separator occurrence would then misparse IDs
+// whose value happens to encode to text containing that character.
+func NewGenerator(opts ...Option) *Generator {
+	g := &Generator{
+		epoch:     epochTime,
+		prefixLen: prefixAllowedLen,
+		separator: separator,
+		encoding:  EncodingBase58,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	g.sepBytes = []byte(g.separator)
+	if strings.ContainsAny(g.encoding.Alphabet(), g.separator) {
+		panic(fmt.Sprintf("rapidid: separator %q overlaps a character in the %s encoding's alphabet",
+			g.separator, g.encoding.Name()))
+	}
+	return g
+}
+
+// Generate is the same as GenerateWithPrefix("").
+func (g *Generator) Generate() string {
+	return g.GenerateWithPrefix("")
+}
+
+// GenerateWithPrefix is syntactic sugar for New(prefix) followed by
+// stringifying the result, and panics if New returns an error.
+func (g *Generator) GenerateWithPrefix(prefix string) string {
+	prefix = strings.TrimSuffix(prefix, g.separator)
+	id, err := g.New(prefix)
+	if err != nil {
+		panic(err)
+	}
+	return g.string(id)
+}
+
+// New creates a 152 bit time ordered universal ID using this Generator's
+// epoch and prefix length.
+func (g *Generator) New(prefix string) (ID, error) {
+	prefixLen, prefix, err := g.splitPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	ts := uint64(time.Since(g.epoch) / 100) // timestamp measured in 100 unit nanoseconds
+	ts = (ts << 8) & 0xFFFFFFFFFFFFFF00     // the 56 least significant bits of the time
+	rnd := getRandomBytes()                 // 96 bits randomness for time collisions
+	if g.nodeID != nil {
+		copy(rnd[:nodeIDLen], g.nodeID)
+	}
+	id := make(ID, prefixLen+byteLength)
+	copy(id[0:prefixLen], prefix)
+	putTimestampBytes(id[prefixLen:], ts)
+	copy(id[prefixLen+timeBytesLen:], rnd)
+	return id, nil
+}
+
+// string encodes id using this Generator's separator and encoding.
+func (g *Generator) string(id ID) string {
+	prefixPart := ""
+	valuePart := id[:]
+	if len(id) > byteLength {
+		separatorIndex := bytes.Index(id[:], g.sepBytes)
+		if separatorIndex == -1 {
+			panic(fmt.Sprintf("expecting the separator "+
+				"'%s' but none was found", g.separator))
+		}
+		prefixPart = string(id[0:separatorIndex]) + g.separator
+		valuePart = id[separatorIndex+len(g.sepBytes):]
+	}
+	return prefixPart + g.encoding.Encode(valuePart)
+}
+
+// Parse decodes text, produced by Generate or GenerateWithPrefix, back into
+// an ID. Besides this Generator's configured encoding, it also recognizes
+// any other encoding in knownEncodings whose length matches text, so
+// deployments migrating from one encoding to another can still read IDs
+// produced under the old one.
+func (g *Generator) Parse(text string) (ID, error) {
+	sepIndex := strings.Index(text, g.separator)
+	if sepIndex != -1 {
+		return g.parseInternal(text[:sepIndex], text[sepIndex+len(g.sepBytes):])
+	}
+	return g.parseInternal("", text)
+}
+
+func (g *Generator) parseInternal(prefix, text string) (ID, error) {
+	enc := g.resolveEncoding(text)
+	if len(text) < enc.EncodedLen(byteLength) {
+		return nil, fmt.Errorf("invalid identifier string: must have at least %d characters for the %s encoding",
+			enc.EncodedLen(byteLength), enc.Name())
+	}
+	if err := g.validatePrefix(prefix, false); err != nil {
+		return nil, err
+	}
+	bs, err := enc.Decode(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID: must be a valid %s text", enc.Name())
+	}
+	raw := bs
+	if prefix != "" {
+		raw = append([]byte(prefix+g.separator), bs...)
+	}
+	return g.FromBytes(raw)
+}
+
+// resolveEncoding returns the encoding whose EncodedLen matches len(text),
+// preferring this Generator's configured encoding, and falling back to
+// scanning knownEncodings before giving up and returning the configured
+// encoding anyway (so its own error message surfaces to the caller).
+func (g *Generator) resolveEncoding(text string) Encoding {
+	if g.encoding.EncodedLen(byteLength) == len(text) {
+		return g.encoding
+	}
+	for _, enc := range knownEncodings {
+		if enc.EncodedLen(byteLength) == len(text) {
+			return enc
+		}
+	}
+	return g.encoding
+}
+
+// FromBytes wraps b as an ID. It behaves the same regardless of this
+// Generator's configuration, since the binary representation of an ID
+// carries no encoding information.
+func (g *Generator) FromBytes(b []byte) (ID, error) {
+	return FromBytes(b)
+}
+
+// Prefix returns the prefix id was generated with by this Generator, or an
+// empty string if it was generated without one. Unlike ID.Prefix, which
+// assumes the default "-" separator, this honours the Generator's own
+// separator.
+func (g *Generator) Prefix(id ID) string {
+	end := len(id) - byteLength - len(g.sepBytes)
+	if end <= 0 {
+		return ""
+	}
+	return string(id[0:end])
+}
+
+// Timestamp returns the raw counter of 100ns ticks since this Generator's
+// epoch that was encoded into id at generation time.
+func (g *Generator) Timestamp(id ID) uint64 {
+	return id.Timestamp()
+}
+
+// Time decodes the 56-bit timestamp embedded in id, measured against this
+// Generator's epoch, and returns the instant it represents.
+func (g *Generator) Time(id ID) time.Time {
+	return g.epoch.Add(time.Duration(g.Timestamp(id)) * 100 * time.Nanosecond)
+}
+
+// Random returns a copy of the 12-byte random component of id.
+func (g *Generator) Random(id ID) []byte {
+	return id.Random()
+}
+
+// NodeID returns a copy of the 3 bytes this Generator's WithNodeID option
+// carves out of the random component of id.
+func (g *Generator) NodeID(id ID) []byte {
+	return id.NodeID()
+}
+
+// validatePrefix mirrors the package-level validatePrefix but honours this
+// Generator's configured prefix length and separator.
+func (g *Generator) validatePrefix(str string, separatorAsInvalid bool) error {
+	if !separatorAsInvalid {
+		str = strings.TrimSuffix(str, g.separator)
+	}
+	if strings.Contains(str, g.separator) {
+		return fmt.Errorf("prefix must not contain '%s'", g.separator)
+	}
+	if strings.Contains(str, " ") {
+		return errors.New("prefix must not contain whitespace")
+	}
+	if len(str) > 0 && len(str) != g.prefixLen {
+		return fmt.Errorf("prefix must be %d characters", g.prefixLen)
+	}
+	return nil
+}
+
+// defaultGenerator backs the package-level Generate/GenerateWithPrefix/New/
+// Parse functions, preserving their historical behavior.
+var defaultGenerator = NewGenerator()
@@ -0,0 +1,20 @@
+package rapidid
+
+import "testing"
+
+func TestNewMonotonicOrdersBurst(t *testing.T) {
+	const n = 1000
+	ids := make([]ID, n)
+	for i := range ids {
+		id, err := NewMonotonic("")
+		if err != nil {
+			t.Fatalf("NewMonotonic: %v", err)
+		}
+		ids[i] = id
+	}
+	for i := 1; i < n; i++ {
+		if string(ids[i-1]) >= string(ids[i]) {
+			t.Fatalf("ids[%d] = %x does not sort after ids[%d] = %x", i, ids[i], i-1, ids[i-1])
+		}
+	}
+}
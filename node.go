@@ -0,0 +1,23 @@
+package rapidid
+
+import (
+	"hash/fnv"
+	"os"
+)
+
+// nodeIDLen is the number of bytes of the random region a Generator
+// configured with WithNodeID carves out for a stable per-process
+// identifier, leaving the remaining bytes random.
+const nodeIDLen = 3
+
+// DefaultNodeID derives a 3-byte node identifier from the FNV-1a hash of the
+// hostname XOR'd with the process ID, for use with WithNodeID. Override it
+// (e.g. on Kubernetes, where HOSTNAME is pod-scoped rather than node-scoped)
+// by passing a different []byte to WithNodeID instead.
+func DefaultNodeID() []byte {
+	hostname, _ := os.Hostname()
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(hostname))
+	sum := h.Sum32() ^ uint32(os.Getpid())
+	return []byte{byte(sum >> 16), byte(sum >> 8), byte(sum)}
+}
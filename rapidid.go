@@ -24,35 +24,29 @@ const (
 )
 
 var (
-	separator             = "-"
-	separatorBytes        = []byte(separator)
-	alphabets             = base58.NewAlphabet("123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz")
-	epochTime             = time.Date(2022, 01, 01, 0, 0, 0, 0, time.UTC)
-	errBytesSizeMismatch  = fmt.Errorf("invalid identifier bytes; must have at least length %d", byteLength)
-	errStringSizeMismatch = fmt.Errorf("invalid identifier string; must have %v or %v characters",
-		stringEncodedLen, stringEncodedLenWithPrefix)
+	separator            = "-"
+	separatorBytes       = []byte(separator)
+	base58AlphabetChars  = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	alphabets            = base58.NewAlphabet(base58AlphabetChars)
+	epochTime            = time.Date(2022, 01, 01, 0, 0, 0, 0, time.UTC)
+	errBytesSizeMismatch = fmt.Errorf("invalid identifier bytes; must have at least length %d", byteLength)
 )
 
 // Generate is the same as GenerateWithPrefix("")
 func Generate() string {
-	return GenerateWithPrefix("")
+	return defaultGenerator.Generate()
 }
 
 // GenerateWithPrefix is syntactic sugar to New().String() and panic if New() returns error
 func GenerateWithPrefix(prefix string) string {
-	prefix = strings.TrimSuffix(prefix, separator)
-	id, err := New(prefix)
-	if err != nil {
-		panic(err)
-	}
-	return id.String()
+	return defaultGenerator.GenerateWithPrefix(prefix)
 }
 
 // New creates a 152 bits time ordered universal ID
 // with the specified prefix used to identifying similar IDs.
 // The prefix must be and empty string to a 3-letter word
 // without whitespace or hyphens
-func New(prefix string) (ID, error) { return newID(prefix) }
+func New(prefix string) (ID, error) { return defaultGenerator.New(prefix) }
 
 // Bytes gives the raw byte representation of ID
 func (t ID) Bytes() []byte {
@@ -63,20 +57,13 @@ func (t ID) Bytes() []byte {
 // The length of the string can be 26 or 30 if a prefix was set.
 func (t ID) String() string {
 	prefixPart := ""
-	valuePart := t[:]
-	if len(t) > byteLength {
-		separatorIndex := bytes.Index(t[:], separatorBytes)
-		if separatorIndex == -1 {
-			panic(fmt.Sprintf("epxpecting the separator "+
-				"'%s' but non was found", separator))
-		}
-		prefixPart = string(t[0 : separatorIndex+1])
-		valuePart = t[separatorIndex+1:]
+	if prefix := t.Prefix(); prefix != "" {
+		prefixPart = prefix + separator
 	}
 	// We Base58 implementation as the encoding to use for the generated ID;
 	// the beauty of this implementation is that it preserves lexical ordering
 	// as defined as in the ASCII table.
-	return prefixPart + base58.EncodeAlphabet(valuePart, alphabets)
+	return prefixPart + base58.EncodeAlphabet(t.valuePart(), alphabets)
 }
 
 // Value converts the ID into a SQL driver value
@@ -153,53 +140,22 @@ func (t *ID) scan(b []byte) error {
 	}
 }
 
-func newID(prefix string) (ID, error) {
-	prefixLen := 0
-	if err := validatePrefix(prefix, true); err != nil {
-		return nil, err
-	} else if len(prefix) > 0 {
-		prefix += separator
-		prefixLen = prefixAllowedLen + len(separator)
-	}
-	ts := uint64(time.Since(epochTime) / 100) // timestamp measured in 100 unit nanoseconds
-	ts = (ts << 8) & 0xFFFFFFFFFFFFFF00       // the 56 least significant bits of the time
-	rnd := getRandomBytes()                   // 96 bits randomness for time collisions
-	id := make(ID, prefixLen+byteLength)
-	copy(id[0:prefixLen], prefix)
-	id[prefixLen+0] = byte(ts >> 56)
-	id[prefixLen+1] = byte(ts >> 48)
-	id[prefixLen+2] = byte(ts >> 40)
-	id[prefixLen+3] = byte(ts >> 32)
-	id[prefixLen+4] = byte(ts >> 24)
-	id[prefixLen+5] = byte(ts >> 16)
-	id[prefixLen+6] = byte(ts >> 8)
-	copy(id[prefixLen+7:], rnd)
-	return id, nil
+// putTimestampBytes writes the upper 7 bytes of ts, big-endian, into the
+// beginning of dst; the low byte of ts is dropped, so callers pass the tick
+// count shifted left by 8.
+func putTimestampBytes(dst []byte, ts uint64) {
+	dst[0] = byte(ts >> 56)
+	dst[1] = byte(ts >> 48)
+	dst[2] = byte(ts >> 40)
+	dst[3] = byte(ts >> 32)
+	dst[4] = byte(ts >> 24)
+	dst[5] = byte(ts >> 16)
+	dst[6] = byte(ts >> 8)
 }
 
-func Parse(text string) (ID, error) {
-	prefixIndex := strings.Index(text, separator)
-	if prefixIndex != -1 {
-		return parseInternal(text[:prefixIndex+1], text[prefixIndex+1:])
-	} else if len(text) >= stringEncodedLen {
-		return parseInternal("", text)
-	}
-	return nil, errStringSizeMismatch
-}
-
-func parseInternal(prefix, text string) (ID, error) {
-	if len(text) < stringEncodedLen {
-		return nil, errStringSizeMismatch
-	}
-	if err := validatePrefix(prefix, false); err != nil {
-		return nil, err
-	}
-	bs, err := base58.DecodeAlphabet(text, alphabets)
-	if err != nil {
-		return nil, fmt.Errorf("invalid ID: must be a valid base58 text")
-	}
-	return FromBytes(append([]byte(prefix), bs...))
-}
+// Parse decodes a string produced by Generate or GenerateWithPrefix back
+// into an ID.
+func Parse(text string) (ID, error) { return defaultGenerator.Parse(text) }
 
 func FromBytes(bytes []byte) (ID, error) {
 	id := make([]byte, len(bytes))